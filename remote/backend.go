@@ -0,0 +1,185 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// lambdaPayload is the wire format sent to the remote handler, regardless of
+// which Backend transports it.
+type lambdaPayload struct {
+	Action   string                 `json:"action"`
+	Args     map[string]interface{} `json:"args"`
+	State    map[string]interface{} `json:"state,omitempty"`
+	Store    map[string]interface{} `json:"store,omitempty"`
+	Planning bool                   `json:"planning,omitempty"`
+	Token    string                 `json:"token,omitempty"`
+}
+
+// lambdaResponse is the wire format returned by the remote handler, regardless
+// of which Backend transported it.
+type lambdaResponse struct {
+	ID        string                 `json:"id"`
+	Result    map[string]interface{} `json:"result"`
+	Store     map[string]interface{} `json:"store"`
+	Replace   bool                   `json:"replace"`
+	Reason    string                 `json:"reason"`
+	Status    string                 `json:"status"`
+	Token     string                 `json:"token"`
+	PollAfter string                 `json:"poll_after"`
+}
+
+// Backend is the pluggable transport used to invoke the handler that
+// implements the remote_resource action/args/state/store protocol. The
+// protocol itself never changes between backends; only how the payload gets
+// to the handler and back does.
+type Backend interface {
+	Invoke(ctx context.Context, payload lambdaPayload) (*lambdaResponse, error)
+}
+
+// buildBackend resolves the configured Backend from the provider schema. A
+// "backend" block takes precedence; when absent, the legacy root-level
+// "lambda"/"region" attributes are used so existing configurations keep
+// working unchanged.
+func buildBackend(d *schema.ResourceData) (Backend, error) {
+	if raw, ok := d.GetOk("backend"); ok {
+		blocks := raw.([]interface{})
+		if len(blocks) == 1 {
+			return buildBackendFromBlock(blocks[0].(map[string]interface{}))
+		}
+	}
+
+	lambdaName := d.Get("lambda").(string)
+	if lambdaName == "" {
+		return nil, fmt.Errorf("either \"lambda\" or a \"backend\" block must be configured")
+	}
+	return newLambdaBackend(lambdaName, d.Get("region").(string), defaultLambdaRetryConfig)
+}
+
+func buildBackendFromBlock(block map[string]interface{}) (Backend, error) {
+	lambdaBlocks := block["lambda"].([]interface{})
+	httpBlocks := block["http"].([]interface{})
+	execBlocks := block["exec"].([]interface{})
+
+	configured := 0
+	for _, b := range [][]interface{}{lambdaBlocks, httpBlocks, execBlocks} {
+		if len(b) == 1 {
+			configured++
+		}
+	}
+	if configured != 1 {
+		return nil, fmt.Errorf("backend block must configure exactly one of \"lambda\", \"http\", or \"exec\"")
+	}
+
+	switch {
+	case len(lambdaBlocks) == 1:
+		b := lambdaBlocks[0].(map[string]interface{})
+		retry, err := parseLambdaRetryConfig(b["retry"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		return newLambdaBackend(b["name"].(string), b["region"].(string), retry)
+	case len(httpBlocks) == 1:
+		return newHTTPBackend(httpBlocks[0].(map[string]interface{}))
+	default:
+		return newExecBackend(execBlocks[0].(map[string]interface{}))
+	}
+}
+
+// parseLambdaRetryConfig parses the backend.lambda.retry block. Every field
+// has a schema-level default, so defaultLambdaRetryConfig only applies when
+// the block itself is omitted.
+func parseLambdaRetryConfig(blocks []interface{}) (lambdaRetryConfig, error) {
+	if len(blocks) != 1 {
+		return defaultLambdaRetryConfig, nil
+	}
+	block := blocks[0].(map[string]interface{})
+
+	initialBackoff, err := time.ParseDuration(block["initial_backoff"].(string))
+	if err != nil {
+		return lambdaRetryConfig{}, fmt.Errorf("backend.lambda.retry.initial_backoff: invalid duration: %w", err)
+	}
+	maxBackoff, err := time.ParseDuration(block["max_backoff"].(string))
+	if err != nil {
+		return lambdaRetryConfig{}, fmt.Errorf("backend.lambda.retry.max_backoff: invalid duration: %w", err)
+	}
+
+	return lambdaRetryConfig{
+		MaxRetries:     block["max_retries"].(int),
+		InitialBackoff: initialBackoff,
+		MaxBackoff:     maxBackoff,
+		Jitter:         block["jitter"].(bool),
+	}, nil
+}
+
+// parseInvokeResponse normalizes the raw JSON bytes returned by any backend
+// into a lambdaResponse, tolerating handlers that return "result"/"store" as
+// either nested objects or JSON-encoded strings.
+func parseInvokeResponse(source string, raw []byte) (*lambdaResponse, error) {
+	log.Printf("[INFO] %s response: %s", source, string(raw))
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(raw, &out); err != nil {
+		log.Printf("[ERROR] failed to unmarshal %s response: %v", source, err)
+		return nil, err
+	}
+
+	var resultVal map[string]interface{}
+	if res, ok := out["result"]; ok {
+		switch v := res.(type) {
+		case map[string]interface{}:
+			resultVal = v
+		case string:
+			if err := json.Unmarshal([]byte(v), &resultVal); err != nil {
+				log.Printf("[ERROR] could not unmarshal result string: %v", err)
+				resultVal = map[string]interface{}{}
+			}
+		default:
+			log.Printf("[ERROR] unexpected result type: %T", v)
+			resultVal = map[string]interface{}{}
+		}
+	} else {
+		resultVal = map[string]interface{}{}
+	}
+
+	var storeVal map[string]interface{}
+	if store, ok := out["store"]; ok {
+		switch v := store.(type) {
+		case map[string]interface{}:
+			storeVal = v
+		case string:
+			_ = json.Unmarshal([]byte(v), &storeVal)
+		default:
+			storeVal = map[string]interface{}{}
+		}
+	}
+
+	replace, _ := out["replace"].(bool)
+	reason, _ := out["reason"].(string)
+	status, _ := out["status"].(string)
+	token, _ := out["token"].(string)
+	pollAfter, _ := out["poll_after"].(string)
+
+	id := ""
+	if resultVal != nil {
+		if idRaw, ok := resultVal["id"]; ok {
+			id, _ = idRaw.(string)
+		}
+	}
+
+	return &lambdaResponse{
+		ID:        id,
+		Result:    resultVal,
+		Store:     storeVal,
+		Replace:   replace,
+		Reason:    reason,
+		Status:    status,
+		Token:     token,
+		PollAfter: pollAfter,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+// execBackend invokes the remote handler by spawning a local binary, writing
+// the JSON payload to its stdin, and reading the JSON response from its
+// stdout. This mirrors Terraform's local-exec provisioner and lets users
+// prototype a handler locally before deploying it behind a real backend.
+type execBackend struct {
+	Command string
+	Args    []string
+	Env     map[string]string
+}
+
+func newExecBackend(block map[string]interface{}) (*execBackend, error) {
+	command, _ := block["command"].(string)
+	if command == "" {
+		return nil, fmt.Errorf("backend.exec.command is required")
+	}
+
+	var args []string
+	if raw, ok := block["args"].([]interface{}); ok {
+		for _, a := range raw {
+			args = append(args, fmt.Sprintf("%v", a))
+		}
+	}
+
+	env := map[string]string{}
+	if raw, ok := block["env"].(map[string]interface{}); ok {
+		for k, v := range raw {
+			env[k] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return &execBackend{Command: command, Args: args, Env: env}, nil
+}
+
+func (b *execBackend) Invoke(ctx context.Context, payload lambdaPayload) (*lambdaResponse, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.CommandContext(ctx, b.Command, b.Args...)
+	cmd.Stdin = bytes.NewReader(body)
+	if len(b.Env) > 0 {
+		cmd.Env = os.Environ()
+		for k, v := range b.Env {
+			cmd.Env = append(cmd.Env, k+"="+v)
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("exec backend: %s failed: %w (stderr: %s)", b.Command, err, stderr.String())
+	}
+
+	return parseInvokeResponse("exec", stdout.Bytes())
+}
@@ -0,0 +1,100 @@
+package remote
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// httpBackend invokes the remote handler by POSTing the JSON payload to a
+// URL and treating the JSON response body as the protocol response. Useful
+// for handlers running behind an HTTP endpoint (e.g. in Kubernetes) instead
+// of as a Lambda function.
+type httpBackend struct {
+	URL     string
+	Headers map[string]string
+	Client  *http.Client
+}
+
+func newHTTPBackend(block map[string]interface{}) (*httpBackend, error) {
+	url, _ := block["url"].(string)
+	if url == "" {
+		return nil, fmt.Errorf("backend.http.url is required")
+	}
+
+	headers := map[string]string{}
+	if raw, ok := block["headers"].(map[string]interface{}); ok {
+		for k, v := range raw {
+			headers[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	if token, ok := block["bearer_token"].(string); ok && token != "" {
+		headers["Authorization"] = "Bearer " + token
+	}
+
+	tlsConfig := &tls.Config{}
+	if skip, ok := block["insecure_skip_verify"].(bool); ok {
+		tlsConfig.InsecureSkipVerify = skip
+	}
+	cert, _ := block["tls_client_cert"].(string)
+	key, _ := block["tls_client_key"].(string)
+	if cert != "" && key != "" {
+		pair, err := tls.X509KeyPair([]byte(cert), []byte(key))
+		if err != nil {
+			return nil, fmt.Errorf("backend.http: invalid tls_client_cert/tls_client_key: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{pair}
+	}
+	if ca, ok := block["tls_ca_cert"].(string); ok && ca != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(ca)) {
+			return nil, fmt.Errorf("backend.http: could not parse tls_ca_cert")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return &httpBackend{
+		URL:     url,
+		Headers: headers,
+		Client: &http.Client{
+			Transport: &http.Transport{TLSClientConfig: tlsConfig},
+		},
+	}, nil
+}
+
+func (b *httpBackend) Invoke(ctx context.Context, payload lambdaPayload) (*lambdaResponse, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, b.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range b.Headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := b.Client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("http backend: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("http backend: could not read response body: %w", err)
+	}
+	if resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("http backend: handler returned status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	return parseInvokeResponse("http", respBody)
+}
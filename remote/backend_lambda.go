@@ -0,0 +1,155 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+// lambdaRetryConfig bounds the exponential backoff applied to retryable
+// Lambda invocation failures.
+type lambdaRetryConfig struct {
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Jitter         bool
+}
+
+var defaultLambdaRetryConfig = lambdaRetryConfig{
+	MaxRetries:     3,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     10 * time.Second,
+	Jitter:         true,
+}
+
+// lambdaBackend is the original transport: it invokes an AWS Lambda function
+// and treats its synchronous response payload as the protocol response.
+type lambdaBackend struct {
+	LambdaName string
+	Svc        *lambda.Lambda
+	Retry      lambdaRetryConfig
+}
+
+func newLambdaBackend(lambdaName, region string, retry lambdaRetryConfig) (*lambdaBackend, error) {
+	var sess *session.Session
+	if strings.HasPrefix(lambdaName, "arn:") {
+		sess = session.Must(session.NewSession())
+	} else {
+		if region == "" {
+			return nil, fmt.Errorf("region is required when lambda is not an ARN")
+		}
+		awsCfg := aws.NewConfig().WithRegion(region)
+		sess = session.Must(session.NewSession(awsCfg))
+	}
+
+	return &lambdaBackend{
+		LambdaName: lambdaName,
+		Svc:        lambda.New(sess),
+		Retry:      retry,
+	}, nil
+}
+
+func (b *lambdaBackend) Invoke(ctx context.Context, payload lambdaPayload) (*lambdaResponse, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("[INFO] invoking Lambda %s with payload: %s", b.LambdaName, string(body))
+
+	backoff := b.Retry.InitialBackoff
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 {
+			wait := backoff
+			if b.Retry.Jitter {
+				wait = jitter(wait)
+			}
+			log.Printf("[WARN] retrying Lambda invocation (attempt %d/%d) after %s: %v", attempt+1, b.Retry.MaxRetries+1, wait, lastErr)
+			select {
+			case <-ctx.Done():
+				return nil, fmt.Errorf("lambda invocation canceled while waiting to retry: %w", ctx.Err())
+			case <-time.After(wait):
+			}
+			backoff *= 2
+			if backoff > b.Retry.MaxBackoff {
+				backoff = b.Retry.MaxBackoff
+			}
+		}
+
+		resp, err := b.Svc.InvokeWithContext(ctx, &lambda.InvokeInput{
+			FunctionName: aws.String(b.LambdaName),
+			Payload:      body,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, fmt.Errorf("lambda invocation canceled: %w", ctx.Err())
+			}
+			if attempt < b.Retry.MaxRetries && isRetryableInvokeError(err) {
+				lastErr = err
+				continue
+			}
+			log.Printf("[ERROR] lambda invocation failed: %v", err)
+			return nil, err
+		}
+		if resp.FunctionError != nil {
+			functionErr := fmt.Errorf("lambda error: %s", string(resp.Payload))
+			if attempt < b.Retry.MaxRetries && isRetryableFunctionError(resp) {
+				lastErr = functionErr
+				continue
+			}
+			log.Printf("[ERROR] lambda returned function error: %s", string(resp.Payload))
+			return nil, functionErr
+		}
+
+		return parseInvokeResponse("lambda", resp.Payload)
+	}
+}
+
+// isRetryableInvokeError reports whether err is a transient AWS error worth
+// retrying, namely throttling or an internal Lambda service error.
+func isRetryableInvokeError(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	if !ok {
+		return false
+	}
+	switch awsErr.Code() {
+	case lambda.ErrCodeTooManyRequestsException, lambda.ErrCodeServiceException:
+		return true
+	default:
+		return false
+	}
+}
+
+// isRetryableFunctionError reports whether a synchronous invocation that
+// returned a FunctionError is worth retrying: only a handler that wraps an
+// HTTP-style 5xx status code in its payload. An unhandled exception is not
+// retried here, since the handler may have already performed a non-idempotent
+// side effect (e.g. creating the remote resource) before crashing.
+func isRetryableFunctionError(resp *lambda.InvokeOutput) bool {
+	var probe struct {
+		StatusCode int `json:"statusCode"`
+	}
+	if err := json.Unmarshal(resp.Payload, &probe); err != nil {
+		return false
+	}
+	return probe.StatusCode >= 500
+}
+
+// jitter randomizes d to somewhere between half of d and d, so retries from
+// multiple concurrent operations don't all land on the same instant.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return d
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
@@ -0,0 +1,98 @@
+package remote
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/lambda"
+)
+
+func TestJitterBounds(t *testing.T) {
+	d := 1000 * time.Millisecond
+	for i := 0; i < 100; i++ {
+		got := jitter(d)
+		if got < d/2 || got > d {
+			t.Fatalf("jitter(%v) = %v, want within [%v, %v]", d, got, d/2, d)
+		}
+	}
+}
+
+func TestJitterNonPositive(t *testing.T) {
+	if got := jitter(0); got != 0 {
+		t.Errorf("jitter(0) = %v, want 0", got)
+	}
+	if got := jitter(-1); got != -1 {
+		t.Errorf("jitter(-1) = %v, want -1", got)
+	}
+}
+
+func TestIsRetryableInvokeError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"too many requests", awserr.New(lambda.ErrCodeTooManyRequestsException, "throttled", nil), true},
+		{"service exception", awserr.New(lambda.ErrCodeServiceException, "internal error", nil), true},
+		{"resource not found", awserr.New(lambda.ErrCodeResourceNotFoundException, "no such function", nil), false},
+		{"non-aws error", errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableInvokeError(tt.err); got != tt.want {
+				t.Errorf("isRetryableInvokeError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryableFunctionError(t *testing.T) {
+	tests := []struct {
+		name string
+		resp *lambda.InvokeOutput
+		want bool
+	}{
+		{
+			name: "unhandled exception is not retried",
+			resp: &lambda.InvokeOutput{
+				FunctionError: aws.String("Unhandled"),
+				Payload:       []byte(`{"errorMessage":"panic"}`),
+			},
+			want: false,
+		},
+		{
+			name: "5xx status in payload is retried",
+			resp: &lambda.InvokeOutput{
+				FunctionError: aws.String("Handled"),
+				Payload:       []byte(`{"statusCode":503}`),
+			},
+			want: true,
+		},
+		{
+			name: "4xx status in payload is not retried",
+			resp: &lambda.InvokeOutput{
+				FunctionError: aws.String("Handled"),
+				Payload:       []byte(`{"statusCode":400}`),
+			},
+			want: false,
+		},
+		{
+			name: "non-JSON payload is not retried",
+			resp: &lambda.InvokeOutput{
+				FunctionError: aws.String("Unhandled"),
+				Payload:       []byte(`not json`),
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableFunctionError(tt.resp); got != tt.want {
+				t.Errorf("isRetryableFunctionError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceRemote queries the backend's lifecycle protocol for read-only
+// data: it issues action "data" against the configured backend and exposes
+// whatever "result"/"store" the handler returns, without managing create,
+// update, or delete.
+func dataSourceRemote() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceRemoteRead,
+		Schema: map[string]*schema.Schema{
+			"args": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Required: true,
+			},
+			"result": {
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"store": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceRemoteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*remoteClient)
+	ctx, cancel := context.WithTimeout(ctx, client.timeoutFor("read"))
+	defer cancel()
+
+	argsStr := d.Get("args")
+	args, err := parseArgsJSON(argsStr)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("data: %w", err))
+	}
+
+	// A request/response schema is optional for the "data" action, so a
+	// Lambda that doesn't implement "schema" at all still works here.
+	schemas := getSchemasLenient(ctx, client)
+	applyOperations(schemas.Request, args)
+	if err := validateWithSchema(schemas.Request, args, "request"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := invokeLambda(ctx, client, lambdaPayload{Action: "data", Args: args})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("data read failed: %w", err))
+	}
+	if res.ID == "" {
+		return diag.FromErr(fmt.Errorf("lambda data response missing required 'id' field or returned empty id"))
+	}
+	applyOperations(schemas.Response, res.Result)
+	if err := validateWithSchema(schemas.Response, res.Result, "response"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(res.ID)
+	if err := d.Set("result", mapStringValues(res.Result)); err != nil {
+		return diag.FromErr(fmt.Errorf("failed to set result: %w", err))
+	}
+	if err := setStoreAsJSONString(d, res.Store); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceRemoteImport implements `terraform import` for remote_resource: it
+// sends action "import" with the import ID as args.id and expects the
+// handler to reply with a full result/store payload, which is then used to
+// hydrate id, args, result, and store.
+func resourceRemoteImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := m.(*remoteClient)
+	ctx, cancel := context.WithTimeout(ctx, client.timeoutFor("read"))
+	defer cancel()
+
+	// A request/response schema is optional for the "import" action, so a
+	// Lambda that doesn't implement "schema" at all still works here.
+	schemas := getSchemasLenient(ctx, client)
+
+	res, err := invokeLambda(ctx, client, lambdaPayload{
+		Action: "import",
+		Args:   map[string]interface{}{"id": d.Id()},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("import failed: %w", err)
+	}
+	if res.ID == "" {
+		return nil, fmt.Errorf("lambda import response missing required 'id' field or returned empty id")
+	}
+	applyOperations(schemas.Response, res.Result)
+	if err := validateWithSchema(schemas.Response, res.Result, "response"); err != nil {
+		return nil, err
+	}
+
+	args := map[string]interface{}{}
+	if res.Result != nil {
+		if raw, ok := res.Result["args"].(map[string]interface{}); ok {
+			args = raw
+		}
+	}
+	argsBytes, err := json.Marshal(args)
+	if err != nil {
+		return nil, fmt.Errorf("import: could not encode hydrated args: %w", err)
+	}
+
+	d.SetId(res.ID)
+	if err := d.Set("args", []interface{}{string(argsBytes)}); err != nil {
+		return nil, fmt.Errorf("import: could not set args: %w", err)
+	}
+	if err := d.Set("result", mapStringValues(res.Result)); err != nil {
+		return nil, fmt.Errorf("import: could not set result: %w", err)
+	}
+	if err := setStoreAsJSONString(d, res.Store); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,56 @@
+package remote
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+const defaultPollAfter = 5 * time.Second
+
+// invokeLambdaUntilDone invokes payload and, while the handler reports
+// status "pending", keeps invoking action "poll" with the accumulated store
+// and the handler's token until it reports "done" (terminal result) or
+// "failed" (terminal error). A handler that doesn't use the status field at
+// all behaves exactly as before: its first response is already terminal.
+func invokeLambdaUntilDone(ctx context.Context, client *remoteClient, payload lambdaPayload) (*lambdaResponse, error) {
+	res, err := invokeLambda(ctx, client, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	for res.Status == "pending" {
+		wait := defaultPollAfter
+		if res.PollAfter != "" {
+			if d, err := time.ParseDuration(res.PollAfter); err == nil {
+				wait = d
+			} else {
+				log.Printf("[WARN] %s: invalid poll_after %q, using default %s", payload.Action, res.PollAfter, defaultPollAfter)
+			}
+		}
+		log.Printf("[INFO] %s pending (token=%s), polling again in %s", payload.Action, res.Token, wait)
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("%s: canceled while polling: %w", payload.Action, ctx.Err())
+		case <-time.After(wait):
+		}
+
+		res, err = invokeLambda(ctx, client, lambdaPayload{
+			Action: "poll",
+			Args:   payload.Args,
+			State:  payload.State,
+			Store:  res.Store,
+			Token:  res.Token,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if res.Status == "failed" {
+		return nil, fmt.Errorf("%s failed: %s", payload.Action, res.Reason)
+	}
+	return res, nil
+}
@@ -0,0 +1,123 @@
+package remote
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeBackend replays a scripted sequence of responses, one per Invoke call,
+// so invokeLambdaUntilDone's poll loop can be tested without any Lambda/AWS
+// dependency.
+type fakeBackend struct {
+	responses []*lambdaResponse
+	calls     []lambdaPayload
+}
+
+func (b *fakeBackend) Invoke(ctx context.Context, payload lambdaPayload) (*lambdaResponse, error) {
+	b.calls = append(b.calls, payload)
+	if len(b.calls) > len(b.responses) {
+		return nil, context.DeadlineExceeded
+	}
+	return b.responses[len(b.calls)-1], nil
+}
+
+func TestInvokeLambdaUntilDonePendingThenDone(t *testing.T) {
+	backend := &fakeBackend{
+		responses: []*lambdaResponse{
+			{Status: "pending", Token: "tok-1", PollAfter: "1ms", Store: map[string]interface{}{"step": 1.0}},
+			{Status: "pending", Token: "tok-2", PollAfter: "1ms", Store: map[string]interface{}{"step": 2.0}},
+			{Status: "done", ID: "abc", Result: map[string]interface{}{"ok": true}},
+		},
+	}
+	client := &remoteClient{Backend: backend}
+
+	res, err := invokeLambdaUntilDone(context.Background(), client, lambdaPayload{Action: "create", Args: map[string]interface{}{"name": "x"}})
+	if err != nil {
+		t.Fatalf("invokeLambdaUntilDone() error = %v", err)
+	}
+	if res.ID != "abc" || res.Result["ok"] != true {
+		t.Errorf("invokeLambdaUntilDone() = %#v, want terminal done response", res)
+	}
+	if len(backend.calls) != 3 {
+		t.Fatalf("expected 3 invocations (1 initial + 2 polls), got %d", len(backend.calls))
+	}
+	if backend.calls[0].Action != "create" {
+		t.Errorf("first call action = %q, want %q", backend.calls[0].Action, "create")
+	}
+	for i, call := range backend.calls[1:] {
+		if call.Action != "poll" {
+			t.Errorf("poll call %d action = %q, want %q", i, call.Action, "poll")
+		}
+	}
+	if backend.calls[1].Token != "tok-1" {
+		t.Errorf("first poll token = %q, want %q", backend.calls[1].Token, "tok-1")
+	}
+	if backend.calls[2].Token != "tok-2" {
+		t.Errorf("second poll token = %q, want %q", backend.calls[2].Token, "tok-2")
+	}
+}
+
+func TestInvokeLambdaUntilDonePendingThenFailed(t *testing.T) {
+	backend := &fakeBackend{
+		responses: []*lambdaResponse{
+			{Status: "pending", Token: "tok-1", PollAfter: "1ms"},
+			{Status: "failed", Reason: "remote side blew up"},
+		},
+	}
+	client := &remoteClient{Backend: backend}
+
+	_, err := invokeLambdaUntilDone(context.Background(), client, lambdaPayload{Action: "update"})
+	if err == nil {
+		t.Fatal("invokeLambdaUntilDone() error = nil, want failure")
+	}
+	if !strings.Contains(err.Error(), "remote side blew up") {
+		t.Errorf("invokeLambdaUntilDone() error = %v, want it to contain the failure reason", err)
+	}
+}
+
+func TestInvokeLambdaUntilDoneCanceledWhilePolling(t *testing.T) {
+	backend := &fakeBackend{
+		responses: []*lambdaResponse{
+			{Status: "pending", Token: "tok-1", PollAfter: "1h"},
+		},
+	}
+	client := &remoteClient{Backend: backend}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+
+	_, err := invokeLambdaUntilDone(ctx, client, lambdaPayload{Action: "delete"})
+	if err == nil {
+		t.Fatal("invokeLambdaUntilDone() error = nil, want cancellation error")
+	}
+	if len(backend.calls) != 1 {
+		t.Errorf("expected no poll call to have been made before cancellation, got %d calls", len(backend.calls))
+	}
+}
+
+func TestInvokeLambdaUntilDoneTerminalOnFirstCall(t *testing.T) {
+	// A handler that never uses the status field behaves exactly as before:
+	// its first response is already terminal.
+	backend := &fakeBackend{
+		responses: []*lambdaResponse{
+			{ID: "abc", Result: map[string]interface{}{"ok": true}},
+		},
+	}
+	client := &remoteClient{Backend: backend}
+
+	res, err := invokeLambdaUntilDone(context.Background(), client, lambdaPayload{Action: "create"})
+	if err != nil {
+		t.Fatalf("invokeLambdaUntilDone() error = %v", err)
+	}
+	if res.ID != "abc" {
+		t.Errorf("invokeLambdaUntilDone() = %#v, want the single response returned unchanged", res)
+	}
+	if len(backend.calls) != 1 {
+		t.Errorf("expected exactly 1 invocation, got %d", len(backend.calls))
+	}
+}
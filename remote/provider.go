@@ -6,28 +6,49 @@ import (
 	"fmt"
 	"log"
 	"os"
-	"strings"
 	"sync"
+	"time"
 
-	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/session"
-	"github.com/aws/aws-sdk-go/service/lambda"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/xeipuuv/gojsonschema"
 )
 
 type remoteClient struct {
-	LambdaName string
-	Svc        *lambda.Lambda
-	once       sync.Once
-	schemas    *lambdaSchemaResponse
-	schemaErr  error
+	Backend   Backend
+	Timeouts  map[string]time.Duration
+	Role      string
+	once      sync.Once
+	schemas   *lambdaSchemaResponse
+	schemaErr error
+}
+
+// timeoutFor returns the configured timeout for action ("create", "read",
+// "update", "delete", "diff", or "schema"), falling back to a sane default
+// when the provider's "timeouts" block doesn't set one ("diff" always uses
+// its default, since that block has no "diff" entry).
+func (c *remoteClient) timeoutFor(action string) time.Duration {
+	if d, ok := c.Timeouts[action]; ok && d > 0 {
+		return d
+	}
+	return defaultActionTimeouts[action]
+}
+
+var defaultActionTimeouts = map[string]time.Duration{
+	"create": 5 * time.Minute,
+	"read":   2 * time.Minute,
+	"update": 5 * time.Minute,
+	"delete": 5 * time.Minute,
+	"diff":   2 * time.Minute,
+	"schema": 30 * time.Second,
 }
 
 type lambdaSchemaResponse struct {
-	Request  map[string]interface{} `json:"request"`
-	Response map[string]interface{} `json:"response"`
+	Request     map[string]interface{} `json:"request"`
+	Response    map[string]interface{} `json:"response"`
+	Formats     map[string]string      `json:"formats,omitempty"`
+	Definitions map[string]interface{} `json:"definitions,omitempty"`
 }
 
 func Provider() *schema.Provider {
@@ -35,66 +56,253 @@ func Provider() *schema.Provider {
 		Schema: map[string]*schema.Schema{
 			"lambda": {
 				Type:        schema.TypeString,
-				Required:    true,
+				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("REMOTE_LAMBDA", nil),
-				Description: "Name or ARN of the Lambda function handling lifecycle.",
+				Description: "Name or ARN of the Lambda function handling lifecycle. Ignored when a \"backend\" block is set.",
 			},
 			"region": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("AWS_REGION", nil),
-				Description: "AWS region for the Lambda function if using name instead of ARN.",
+				Description: "AWS region for the Lambda function if using name instead of ARN. Ignored when a \"backend\" block is set.",
+			},
+			"backend": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Selects the transport used to invoke the lifecycle handler. Exactly one of \"lambda\", \"http\", or \"exec\" must be set. Defaults to the legacy \"lambda\"/\"region\" attributes when omitted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"lambda": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Name or ARN of the Lambda function handling lifecycle.",
+									},
+									"region": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										DefaultFunc: schema.EnvDefaultFunc("AWS_REGION", nil),
+										Description: "AWS region for the Lambda function if using name instead of ARN.",
+									},
+									"retry": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Description: "Bounded exponential backoff applied to throttling, service, and 5xx function errors.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"max_retries": {
+													Type:        schema.TypeInt,
+													Optional:    true,
+													Default:     defaultLambdaRetryConfig.MaxRetries,
+													Description: "Maximum number of retries after the initial attempt.",
+												},
+												"initial_backoff": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Default:     defaultLambdaRetryConfig.InitialBackoff.String(),
+													Description: "Backoff before the first retry, e.g. \"500ms\".",
+												},
+												"max_backoff": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Default:     defaultLambdaRetryConfig.MaxBackoff.String(),
+													Description: "Upper bound the exponential backoff is capped at, e.g. \"10s\".",
+												},
+												"jitter": {
+													Type:        schema.TypeBool,
+													Optional:    true,
+													Default:     defaultLambdaRetryConfig.Jitter,
+													Description: "Randomize each backoff to avoid retries from concurrent operations synchronizing.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"http": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"url": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Endpoint that receives POSTed JSON payloads and returns the JSON response.",
+									},
+									"headers": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Extra headers sent with every request.",
+									},
+									"bearer_token": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Sensitive:   true,
+										Description: "Value sent as an \"Authorization: Bearer\" header.",
+									},
+									"tls_client_cert": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "PEM-encoded client certificate used for mTLS.",
+									},
+									"tls_client_key": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Sensitive:   true,
+										Description: "PEM-encoded client key used for mTLS.",
+									},
+									"tls_ca_cert": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "PEM-encoded CA bundle used to verify the server certificate.",
+									},
+									"insecure_skip_verify": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Disable server certificate verification. Do not use in production.",
+									},
+								},
+							},
+						},
+						"exec": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"command": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Path to the local binary that implements the lifecycle protocol.",
+									},
+									"args": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Extra arguments passed to the command.",
+									},
+									"env": {
+										Type:        schema.TypeMap,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Extra environment variables set for the command.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"timeouts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Default per-action timeouts for invoking the backend. Each resource can override create/read/update/delete via its own \"timeouts\" block.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"create": {Type: schema.TypeString, Optional: true, Description: "Default timeout for create, e.g. \"5m\"."},
+						"read":   {Type: schema.TypeString, Optional: true, Description: "Default timeout for read, e.g. \"2m\"."},
+						"update": {Type: schema.TypeString, Optional: true, Description: "Default timeout for update, e.g. \"5m\"."},
+						"delete": {Type: schema.TypeString, Optional: true, Description: "Default timeout for delete, e.g. \"5m\"."},
+						"schema": {Type: schema.TypeString, Optional: true, Description: "Timeout for the one-time schema fetch, e.g. \"30s\"."},
+					},
+				},
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("REMOTE_ROLE", nil),
+				ValidateFunc: validation.StringInSlice([]string{"reader", "writer", "admin"}, false),
+				Description:  "Operator role used to project the Lambda's schema down to properties it is annotated (via \"permission\") to see. Unset disables permission filtering.",
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"remote_resource": resourceRemote(),
 		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"remote_data": dataSourceRemote(),
+		},
 		ConfigureContextFunc: configureProvider,
 	}
 }
 
 func configureProvider(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	lambdaName := d.Get("lambda").(string)
-	region := d.Get("region").(string)
-
-	var sess *session.Session
-	if strings.HasPrefix(lambdaName, "arn:") {
-		sess = session.Must(session.NewSession())
-	} else {
-		if region == "" {
-			return nil, diag.Errorf("region is required when lambda is not an ARN")
-		}
-		awsCfg := aws.NewConfig().WithRegion(region)
-		sess = session.Must(session.NewSession(awsCfg))
+	backend, err := buildBackend(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+
+	timeouts, err := parseProviderTimeouts(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
 	}
 
 	return &remoteClient{
-		LambdaName: lambdaName,
-		Svc:        lambda.New(sess),
+		Backend:  backend,
+		Timeouts: timeouts,
+		Role:     d.Get("role").(string),
 	}, nil
 }
 
-type lambdaPayload struct {
-	Action   string                 `json:"action"`
-	Args     map[string]interface{} `json:"args"`
-	State    map[string]interface{} `json:"state,omitempty"`
-	Store    map[string]interface{} `json:"store,omitempty"`
-	Planning bool                   `json:"planning,omitempty"`
+// parseProviderTimeouts parses the provider's "timeouts" block into a
+// lookup keyed by action name ("create", "read", "update", "delete",
+// "schema"). Missing or empty entries are omitted, leaving the caller to
+// fall back to defaultActionTimeouts.
+func parseProviderTimeouts(d *schema.ResourceData) (map[string]time.Duration, error) {
+	result := map[string]time.Duration{}
+	raw, ok := d.GetOk("timeouts")
+	if !ok {
+		return result, nil
+	}
+	blocks := raw.([]interface{})
+	if len(blocks) != 1 {
+		return result, nil
+	}
+	block := blocks[0].(map[string]interface{})
+	for _, action := range []string{"create", "read", "update", "delete", "schema"} {
+		str, _ := block[action].(string)
+		if str == "" {
+			continue
+		}
+		dur, err := time.ParseDuration(str)
+		if err != nil {
+			return nil, fmt.Errorf("timeouts.%s: invalid duration %q: %w", action, str, err)
+		}
+		result[action] = dur
+	}
+	return result, nil
 }
 
-type lambdaResponse struct {
-	ID      string                 `json:"id"`
-	Result  map[string]interface{} `json:"result"`
-	Store   map[string]interface{} `json:"store"`
-	Replace bool                   `json:"replace"`
-	Reason  string                 `json:"reason"`
+// getSchemasLenient is like getSchemas but tolerates a backend that doesn't
+// implement the "schema" action at all, logging a warning and returning an
+// empty schema instead of an error. Used by paths where a request/response
+// contract is optional, such as the "data" and "import" actions.
+func getSchemasLenient(ctx context.Context, client *remoteClient) *lambdaSchemaResponse {
+	schemas, err := client.getSchemas(ctx)
+	if err != nil {
+		log.Printf("[WARN] schema fetch failed, proceeding without validation: %v", err)
+		return &lambdaSchemaResponse{}
+	}
+	return schemas
 }
 
-func (c *remoteClient) getSchemas() (*lambdaSchemaResponse, error) {
+func (c *remoteClient) getSchemas(ctx context.Context) (*lambdaSchemaResponse, error) {
 	schemaWasFetched := false
 	c.once.Do(func() {
 		log.Printf("[INFO] Requesting schemas from Lambda for the first time...")
-		resp, err := invokeLambda(c, lambdaPayload{Action: "schema"})
+		schemaCtx, cancel := context.WithTimeout(ctx, c.timeoutFor("schema"))
+		defer cancel()
+		resp, err := invokeLambda(schemaCtx, c, lambdaPayload{Action: "schema"})
 		if err != nil {
 			c.schemaErr = err
 			return
@@ -119,6 +327,14 @@ func (c *remoteClient) getSchemas() (*lambdaSchemaResponse, error) {
 		} else {
 			log.Printf("[INFO] No response schema returned from Lambda.")
 		}
+		if len(schemaResp.Formats) > 0 {
+			registerFormats(schemaResp.Formats)
+		}
+		// Resolve $refs/allOf and apply the operator's permission projection
+		// once, up front; every caller of getSchemas sees the same composed,
+		// already-filtered schema.
+		schemaResp.Request = composeSchema(schemaResp.Definitions, c.Role, schemaResp.Request)
+		schemaResp.Response = composeSchema(schemaResp.Definitions, c.Role, schemaResp.Response)
 		c.schemas = &schemaResp
 		schemaWasFetched = true
 	})
@@ -184,7 +400,6 @@ func parseArgsJSON(argsInput interface{}) (map[string]interface{}, error) {
 	}
 }
 
-
 // --- Helper for getPreviousArgs: returns args from state as map[string]interface{} ---
 func getPreviousArgs(d *schema.ResourceData) map[string]interface{} {
 	if d == nil || d.IsNewResource() {
@@ -211,6 +426,15 @@ func resourceRemote() *schema.Resource {
 		ReadContext:   resourceRemoteRead,
 		UpdateContext: resourceRemoteUpdate,
 		DeleteContext: resourceRemoteDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(0),
+			Read:   schema.DefaultTimeout(0),
+			Update: schema.DefaultTimeout(0),
+			Delete: schema.DefaultTimeout(0),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceRemoteImport,
+		},
 		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 			client := meta.(*remoteClient)
 			argsStr := d.Get("args")
@@ -242,7 +466,9 @@ func resourceRemote() *schema.Resource {
 				return nil
 			}
 			// Only call Lambda for diff if there is a previous state (i.e., not create)
-			res, err := invokeLambda(client, lambdaPayload{
+			diffCtx, cancel := context.WithTimeout(ctx, client.timeoutFor("diff"))
+			defer cancel()
+			res, err := invokeLambda(diffCtx, client, lambdaPayload{
 				Action: "diff",
 				Args:   args,
 				State:  oldArgs,
@@ -282,92 +508,15 @@ func resourceRemote() *schema.Resource {
 	}
 }
 
-func invokeLambda(client *remoteClient, payload lambdaPayload) (*lambdaResponse, error) {
-	bytes, err := json.Marshal(payload)
-	if err != nil {
-		return nil, err
-	}
-	log.Printf("[INFO] invoking Lambda %s with payload: %s", client.LambdaName, string(bytes))
-	resp, err := client.Svc.Invoke(&lambda.InvokeInput{
-		FunctionName: aws.String(client.LambdaName),
-		Payload:      bytes,
-	})
-	if err != nil {
-		log.Printf("[ERROR] lambda invocation failed: %v", err)
-		return nil, err
+// invokeLambda sends payload to the provider's configured Backend. The name
+// is a holdover from when AWS Lambda was the only transport; it now just
+// delegates to whichever Backend (lambda, http, exec) the provider resolved.
+func invokeLambda(ctx context.Context, client *remoteClient, payload lambdaPayload) (*lambdaResponse, error) {
+	resp, err := client.Backend.Invoke(ctx, payload)
+	if err != nil && ctx.Err() != nil {
+		return nil, fmt.Errorf("invocation %s: %w", ctx.Err(), err)
 	}
-	if resp.FunctionError != nil {
-		log.Printf("[ERROR] lambda returned function error: %s", string(resp.Payload))
-		return nil, fmt.Errorf("lambda error: %s", string(resp.Payload))
-	}
-	log.Printf("[INFO] lambda response: %s", string(resp.Payload))
-
-	var out map[string]interface{}
-	if err := json.Unmarshal(resp.Payload, &out); err != nil {
-		log.Printf("[ERROR] failed to unmarshal lambda response: %v", err)
-		return nil, err
-	}
-
-	// --- BEGIN: More robust result parsing & debug ---
-	var resultVal map[string]interface{}
-
-	// Print type and value of out["result"] for debugging
-	if res, ok := out["result"]; ok {
-		log.Printf("[DEBUG] Raw Go type for out[\"result\"]: %T", res)
-		b, _ := json.MarshalIndent(res, "", "  ")
-		log.Printf("[DEBUG] Raw value for out[\"result\"]: %s", string(b))
-
-		switch v := res.(type) {
-		case map[string]interface{}:
-			resultVal = v
-		case string:
-			// If it is a string, try to unmarshal it
-			if err := json.Unmarshal([]byte(v), &resultVal); err != nil {
-				log.Printf("[ERROR] Could not unmarshal result string: %v", err)
-				resultVal = map[string]interface{}{}
-			}
-		default:
-			log.Printf("[ERROR] Unexpected result type: %T", v)
-			resultVal = map[string]interface{}{}
-		}
-	} else {
-		resultVal = map[string]interface{}{}
-	}
-
-	// Print the final parsed resultVal as pretty JSON for debugging
-	b, _ := json.MarshalIndent(resultVal, "", "  ")
-	log.Printf("[DEBUG] Parsed resultVal to be set: %s", string(b))
-	// --- END: More robust result parsing & debug ---
-
-	var storeVal map[string]interface{}
-	if store, ok := out["store"]; ok {
-		switch v := store.(type) {
-		case map[string]interface{}:
-			storeVal = v
-		case string:
-			_ = json.Unmarshal([]byte(v), &storeVal)
-		default:
-			storeVal = map[string]interface{}{}
-		}
-	}
-
-	replace, _ := out["replace"].(bool)
-	reason, _ := out["reason"].(string)
-
-	id := ""
-	if resultVal != nil {
-		if idRaw, ok := resultVal["id"]; ok {
-			id, _ = idRaw.(string)
-		}
-	}
-
-	return &lambdaResponse{
-		ID:      id,
-		Result:  resultVal,
-		Store:   storeVal,
-		Replace: replace,
-		Reason:  reason,
-	}, nil
+	return resp, err
 }
 
 func setStoreAsJSONString(d *schema.ResourceData, store map[string]interface{}) error {
@@ -383,6 +532,13 @@ func setStoreAsJSONString(d *schema.ResourceData, store map[string]interface{})
 
 func resourceRemoteCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*remoteClient)
+	timeout := d.Timeout(schema.TimeoutCreate)
+	if timeout == 0 {
+		timeout = client.timeoutFor("create")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	argsStr := d.Get("args")
 	args, err := parseArgsJSON(argsStr)
 	if err != nil {
@@ -398,15 +554,16 @@ func resourceRemoteCreate(ctx context.Context, d *schema.ResourceData, m interfa
 	state := map[string]interface{}{} // No previous state on create
 
 	// Validate args against schema.request
-	schemas, err := client.getSchemas()
+	schemas, err := client.getSchemas(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	applyOperations(schemas.Request, args)
 	if err := validateWithSchema(schemas.Request, args, "request"); err != nil {
 		return diag.FromErr(err)
 	}
 
-	res, err := invokeLambda(client, lambdaPayload{Action: "create", Args: args, State: state, Store: store, Planning: isPlanning()})
+	res, err := invokeLambdaUntilDone(ctx, client, lambdaPayload{Action: "create", Args: args, State: state, Store: store, Planning: isPlanning()})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -414,6 +571,7 @@ func resourceRemoteCreate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(fmt.Errorf("lambda create response missing required 'id' field or returned empty id"))
 	}
 	// Validate result against schema.response
+	applyOperations(schemas.Response, res.Result)
 	if err := validateWithSchema(schemas.Response, res.Result, "response"); err != nil {
 		return diag.FromErr(err)
 	}
@@ -427,6 +585,13 @@ func resourceRemoteCreate(ctx context.Context, d *schema.ResourceData, m interfa
 
 func resourceRemoteRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*remoteClient)
+	timeout := d.Timeout(schema.TimeoutRead)
+	if timeout == 0 {
+		timeout = client.timeoutFor("read")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	argsStr := d.Get("args")
 	args, err := parseArgsJSON(argsStr)
 	if err != nil {
@@ -442,15 +607,16 @@ func resourceRemoteRead(ctx context.Context, d *schema.ResourceData, m interface
 	state := getPreviousArgs(d)
 
 	// Validate args against schema.request
-	schemas, err := client.getSchemas()
+	schemas, err := client.getSchemas(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	applyOperations(schemas.Request, args)
 	if err := validateWithSchema(schemas.Request, args, "request"); err != nil {
 		return diag.FromErr(err)
 	}
 
-	res, err := invokeLambda(client, lambdaPayload{Action: "read", Args: args, State: state, Store: store, Planning: isPlanning()})
+	res, err := invokeLambda(ctx, client, lambdaPayload{Action: "read", Args: args, State: state, Store: store, Planning: isPlanning()})
 	if err != nil {
 		log.Printf("[ERROR] remote read failed: %v", err)
 		return diag.FromErr(fmt.Errorf("remote read failed: %w", err))
@@ -462,6 +628,7 @@ func resourceRemoteRead(ctx context.Context, d *schema.ResourceData, m interface
 		return nil
 	}
 	// Validate result against schema.response
+	applyOperations(schemas.Response, res.Result)
 	if err := validateWithSchema(schemas.Response, res.Result, "response"); err != nil {
 		return diag.FromErr(err)
 	}
@@ -476,6 +643,13 @@ func resourceRemoteRead(ctx context.Context, d *schema.ResourceData, m interface
 
 func resourceRemoteUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*remoteClient)
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if timeout == 0 {
+		timeout = client.timeoutFor("update")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	argsStr := d.Get("args")
 	args, err := parseArgsJSON(argsStr)
 	if err != nil {
@@ -491,16 +665,17 @@ func resourceRemoteUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	state := getPreviousArgs(d)
 
 	// Validate args against schema.request
-	schemas, err := client.getSchemas()
+	schemas, err := client.getSchemas(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	applyOperations(schemas.Request, args)
 	if err := validateWithSchema(schemas.Request, args, "request"); err != nil {
 		return diag.FromErr(err)
 	}
 
 	// Invoke Lambda, don't mutate state yet
-	res, err := invokeLambda(client, lambdaPayload{
+	res, err := invokeLambdaUntilDone(ctx, client, lambdaPayload{
 		Action:   "update",
 		Args:     args,
 		State:    state,
@@ -514,6 +689,7 @@ func resourceRemoteUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(fmt.Errorf("lambda update response missing required 'id' field or returned empty id"))
 	}
 	// Validate result against schema.response
+	applyOperations(schemas.Response, res.Result)
 	if err := validateWithSchema(schemas.Response, res.Result, "response"); err != nil {
 		return diag.FromErr(err)
 	}
@@ -532,9 +708,15 @@ func resourceRemoteUpdate(ctx context.Context, d *schema.ResourceData, m interfa
 	return nil
 }
 
-
 func resourceRemoteDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	client := m.(*remoteClient)
+	timeout := d.Timeout(schema.TimeoutDelete)
+	if timeout == 0 {
+		timeout = client.timeoutFor("delete")
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
 	argsStr := d.Get("args")
 	args, err := parseArgsJSON(argsStr)
 	if err != nil {
@@ -550,15 +732,16 @@ func resourceRemoteDelete(ctx context.Context, d *schema.ResourceData, m interfa
 	state := getPreviousArgs(d)
 
 	// Validate args against schema.request (do NOT validate result)
-	schemas, err := client.getSchemas()
+	schemas, err := client.getSchemas(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	applyOperations(schemas.Request, args)
 	if err := validateWithSchema(schemas.Request, args, "request"); err != nil {
 		return diag.FromErr(err)
 	}
 
-	res, err := invokeLambda(client, lambdaPayload{Action: "delete", Args: args, State: state, Store: store, Planning: isPlanning()})
+	res, err := invokeLambdaUntilDone(ctx, client, lambdaPayload{Action: "delete", Args: args, State: state, Store: store, Planning: isPlanning()})
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -567,6 +750,7 @@ func resourceRemoteDelete(ctx context.Context, d *schema.ResourceData, m interfa
 		return nil
 	}
 	// Do NOT validate response after delete
+	applyOperations(schemas.Response, res.Result)
 	d.SetId(res.ID)
 	d.Set("result", mapStringValues(res.Result))
 	if err := setStoreAsJSONString(d, res.Store); err != nil {
@@ -586,7 +770,7 @@ func flattenMapValues(input map[string]interface{}) map[string]interface{} {
 				out[k] = string(encoded)
 			} else {
 				out[k] = fmt.Sprintf("%v", v)
-			 }
+			}
 		default:
 			out[k] = v
 		}
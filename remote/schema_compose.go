@@ -0,0 +1,209 @@
+package remote
+
+import (
+	"log"
+	"strings"
+)
+
+// composeSchema turns a raw schema fragment returned by the Lambda into the
+// effective schema used for validation: local $refs are resolved against
+// definitions, allOf fragments are merged in, and properties the configured
+// role isn't permitted to see are dropped.
+func composeSchema(definitions map[string]interface{}, role string, raw map[string]interface{}) map[string]interface{} {
+	if raw == nil {
+		return nil
+	}
+	resolved := resolveRefs(definitions, raw)
+	merged := mergeAllOf(resolved)
+	filtered := filterSchemaByPermission(merged, role)
+	out, _ := filtered.(map[string]interface{})
+	return out
+}
+
+// resolveRefs recursively replaces local "$ref": "#/definitions/Name"
+// pointers with a (recursively resolved) copy of that definition. Refs to
+// anything other than "#/definitions/..." are left untouched, since the
+// provider has no document to resolve them against.
+func resolveRefs(definitions map[string]interface{}, node interface{}) interface{} {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		if ref, ok := v["$ref"].(string); ok {
+			if target, ok := lookupDefinition(definitions, ref); ok {
+				return resolveRefs(definitions, target)
+			}
+			log.Printf("[WARN] could not resolve schema $ref %q", ref)
+		}
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			out[k] = resolveRefs(definitions, val)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = resolveRefs(definitions, val)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func lookupDefinition(definitions map[string]interface{}, ref string) (map[string]interface{}, bool) {
+	const prefix = "#/definitions/"
+	if !strings.HasPrefix(ref, prefix) {
+		return nil, false
+	}
+	def, ok := definitions[strings.TrimPrefix(ref, prefix)].(map[string]interface{})
+	return def, ok
+}
+
+// mergeAllOf recursively deep-merges "allOf" fragments into their parent
+// schema object: properties are deep-merged, "required" is unioned, and
+// "propertiesOrder" (a non-standard keyword some schema authors use to hint
+// field ordering) is concatenated.
+func mergeAllOf(node interface{}) interface{} {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			if k == "allOf" {
+				continue
+			}
+			out[k] = mergeAllOf(val)
+		}
+		if fragments, ok := v["allOf"].([]interface{}); ok {
+			for _, fragRaw := range fragments {
+				frag, ok := mergeAllOf(fragRaw).(map[string]interface{})
+				if !ok {
+					continue
+				}
+				mergeSchemaFragment(out, frag)
+			}
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, val := range v {
+			out[i] = mergeAllOf(val)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+func mergeSchemaFragment(dst, frag map[string]interface{}) {
+	if props, ok := frag["properties"].(map[string]interface{}); ok {
+		dstProps, ok := dst["properties"].(map[string]interface{})
+		if !ok {
+			dstProps = map[string]interface{}{}
+		}
+		deepMerge(dstProps, props)
+		dst["properties"] = dstProps
+	}
+	if req := toStringSlice(frag["required"]); len(req) > 0 {
+		dst["required"] = unionStrings(toStringSlice(dst["required"]), req)
+	}
+	if order := toStringSlice(frag["propertiesOrder"]); len(order) > 0 {
+		dst["propertiesOrder"] = append(toStringSlice(dst["propertiesOrder"]), order...)
+	}
+	for k, v := range frag {
+		switch k {
+		case "properties", "required", "propertiesOrder":
+			continue
+		default:
+			if _, exists := dst[k]; !exists {
+				dst[k] = v
+			}
+		}
+	}
+}
+
+func unionStrings(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	out := make([]string, 0, len(a)+len(b))
+	for _, s := range a {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	for _, s := range b {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// permissionLevels orders the roles an operator can declare via the
+// provider's "role" attribute, lowest privilege first.
+var permissionLevels = map[string]int{"reader": 0, "writer": 1, "admin": 2}
+
+// filterSchemaByPermission drops properties annotated with a "permission"
+// keyword the configured role doesn't meet. An empty role disables
+// filtering entirely, so operators who don't opt in see the full schema.
+func filterSchemaByPermission(node interface{}, role string) interface{} {
+	if role == "" {
+		return node
+	}
+	roleLevel, ok := permissionLevels[role]
+	if !ok {
+		log.Printf("[WARN] unknown role %q; schema permission filtering disabled", role)
+		return node
+	}
+	return filterNodeByPermission(node, roleLevel)
+}
+
+func filterNodeByPermission(node interface{}, roleLevel int) interface{} {
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return node
+	}
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+
+	props, ok := m["properties"].(map[string]interface{})
+	if !ok {
+		return out
+	}
+	filteredProps := make(map[string]interface{}, len(props))
+	var dropped []string
+	for name, rawProp := range props {
+		prop, ok := rawProp.(map[string]interface{})
+		if !ok {
+			filteredProps[name] = rawProp
+			continue
+		}
+		if perm, ok := prop["permission"].(string); ok {
+			if level, ok := permissionLevels[perm]; ok && level > roleLevel {
+				dropped = append(dropped, name)
+				continue
+			}
+		}
+		filteredProps[name] = filterNodeByPermission(prop, roleLevel)
+	}
+	out["properties"] = filteredProps
+	if req := toStringSlice(m["required"]); len(req) > 0 {
+		out["required"] = removeStrings(req, dropped)
+	}
+	return out
+}
+
+func removeStrings(in, remove []string) []string {
+	removeSet := make(map[string]bool, len(remove))
+	for _, s := range remove {
+		removeSet[s] = true
+	}
+	out := make([]string, 0, len(in))
+	for _, s := range in {
+		if !removeSet[s] {
+			out = append(out, s)
+		}
+	}
+	return out
+}
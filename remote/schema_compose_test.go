@@ -0,0 +1,190 @@
+package remote
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveRefs(t *testing.T) {
+	definitions := map[string]interface{}{
+		"Address": map[string]interface{}{
+			"type": "object",
+			"properties": map[string]interface{}{
+				"city": map[string]interface{}{"type": "string"},
+			},
+		},
+	}
+	raw := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"home": map[string]interface{}{"$ref": "#/definitions/Address"},
+		},
+	}
+
+	got := resolveRefs(definitions, raw).(map[string]interface{})
+	props := got["properties"].(map[string]interface{})
+	home := props["home"].(map[string]interface{})
+	if home["type"] != "object" {
+		t.Fatalf("expected resolved $ref to inline the definition, got %#v", home)
+	}
+}
+
+func TestResolveRefsUnresolvableLeftUntouched(t *testing.T) {
+	raw := map[string]interface{}{"$ref": "https://example.com/external.json"}
+	got := resolveRefs(map[string]interface{}{}, raw).(map[string]interface{})
+	if got["$ref"] != "https://example.com/external.json" {
+		t.Fatalf("expected unresolvable $ref to be left alone, got %#v", got)
+	}
+}
+
+func TestMergeAllOf(t *testing.T) {
+	raw := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+		},
+		"required": []interface{}{"name"},
+		"allOf": []interface{}{
+			map[string]interface{}{
+				"properties": map[string]interface{}{
+					"age": map[string]interface{}{"type": "integer"},
+				},
+				"required":        []interface{}{"age"},
+				"propertiesOrder": []interface{}{"age"},
+			},
+			map[string]interface{}{
+				"properties": map[string]interface{}{
+					"name": map[string]interface{}{"description": "full name"},
+				},
+			},
+		},
+		"propertiesOrder": []interface{}{"name"},
+	}
+
+	got := mergeAllOf(raw).(map[string]interface{})
+	if _, ok := got["allOf"]; ok {
+		t.Fatalf("expected allOf to be consumed, got %#v", got)
+	}
+
+	props := got["properties"].(map[string]interface{})
+	if _, ok := props["age"]; !ok {
+		t.Fatalf("expected merged fragment property 'age', got %#v", props)
+	}
+	name := props["name"].(map[string]interface{})
+	if name["type"] != "string" || name["description"] != "full name" {
+		t.Fatalf("expected fragment to deep-merge into existing 'name' property, got %#v", name)
+	}
+
+	required := toStringSlice(got["required"])
+	if !reflect.DeepEqual(required, []string{"name", "age"}) {
+		t.Fatalf("expected required to be unioned, got %v", required)
+	}
+
+	order := toStringSlice(got["propertiesOrder"])
+	if !reflect.DeepEqual(order, []string{"name", "age"}) {
+		t.Fatalf("expected propertiesOrder to be concatenated, got %v", order)
+	}
+}
+
+func TestFilterSchemaByPermission(t *testing.T) {
+	schema := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+			"role": map[string]interface{}{"type": "string", "permission": "writer"},
+			"secret": map[string]interface{}{
+				"type":       "string",
+				"permission": "admin",
+			},
+		},
+		"required": []interface{}{"name", "role", "secret"},
+	}
+
+	tests := []struct {
+		role string
+		want []string
+	}{
+		{"", []string{"name", "role", "secret"}},
+		{"reader", []string{"name"}},
+		{"writer", []string{"name", "role"}},
+		{"admin", []string{"name", "role", "secret"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role, func(t *testing.T) {
+			filtered := filterSchemaByPermission(schema, tt.role).(map[string]interface{})
+			props := filtered["properties"].(map[string]interface{})
+			var got []string
+			for name := range props {
+				got = append(got, name)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("role %q: got properties %v, want %v", tt.role, got, tt.want)
+			}
+			for _, name := range tt.want {
+				if _, ok := props[name]; !ok {
+					t.Errorf("role %q: expected property %q to remain, got %v", tt.role, name, got)
+				}
+			}
+			required := toStringSlice(filtered["required"])
+			for _, name := range required {
+				if _, ok := props[name]; !ok {
+					t.Errorf("role %q: 'required' still references dropped property %q", tt.role, name)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterSchemaByPermissionUnknownRole(t *testing.T) {
+	schema := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"secret": map[string]interface{}{"permission": "admin"},
+		},
+	}
+	// An unknown role disables filtering rather than dropping everything.
+	got := filterSchemaByPermission(schema, "superuser").(map[string]interface{})
+	props := got["properties"].(map[string]interface{})
+	if _, ok := props["secret"]; !ok {
+		t.Fatalf("expected unknown role to leave schema untouched, got %#v", got)
+	}
+}
+
+func TestComposeSchema(t *testing.T) {
+	definitions := map[string]interface{}{
+		"Base": map[string]interface{}{
+			"properties": map[string]interface{}{
+				"secret": map[string]interface{}{"type": "string", "permission": "admin"},
+			},
+			"required": []interface{}{"secret"},
+		},
+	}
+	raw := map[string]interface{}{
+		"type": "object",
+		"allOf": []interface{}{
+			map[string]interface{}{"$ref": "#/definitions/Base"},
+		},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{"type": "string"},
+		},
+		"required": []interface{}{"name"},
+	}
+
+	got := composeSchema(definitions, "reader", raw)
+	props := got["properties"].(map[string]interface{})
+	if _, ok := props["secret"]; ok {
+		t.Fatalf("expected reader role to drop admin-only 'secret', got %#v", props)
+	}
+	if _, ok := props["name"]; !ok {
+		t.Fatalf("expected 'name' to survive composition, got %#v", props)
+	}
+	required := toStringSlice(got["required"])
+	if !reflect.DeepEqual(required, []string{"name"}) {
+		t.Fatalf("expected 'secret' to be removed from required after filtering, got %v", required)
+	}
+}
+
+func TestComposeSchemaNilIsNil(t *testing.T) {
+	if got := composeSchema(nil, "", nil); got != nil {
+		t.Fatalf("expected composeSchema(nil) to return nil, got %#v", got)
+	}
+}
@@ -0,0 +1,156 @@
+package remote
+
+import (
+	"log"
+	"net"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// regexFormatChecker validates a string format against a compiled regular
+// expression. Non-string values are left to other keywords (e.g. "type") to
+// reject, matching gojsonschema's own format checker conventions.
+type regexFormatChecker struct {
+	re *regexp.Regexp
+}
+
+func (c regexFormatChecker) IsFormat(input interface{}) bool {
+	str, ok := input.(string)
+	if !ok {
+		return true
+	}
+	return c.re.MatchString(str)
+}
+
+type cidrFormatChecker struct{}
+
+func (cidrFormatChecker) IsFormat(input interface{}) bool {
+	str, ok := input.(string)
+	if !ok {
+		return true
+	}
+	_, _, err := net.ParseCIDR(str)
+	return err == nil
+}
+
+type durationFormatChecker struct{}
+
+func (durationFormatChecker) IsFormat(input interface{}) bool {
+	str, ok := input.(string)
+	if !ok {
+		return true
+	}
+	_, err := time.ParseDuration(str)
+	return err == nil
+}
+
+var semverPattern = regexp.MustCompile(`^v?\d+\.\d+\.\d+(-[0-9A-Za-z-.]+)?(\+[0-9A-Za-z-.]+)?$`)
+
+// wellKnownFormats are the checker ids a Lambda can reference in its
+// "formats" response instead of spelling out a regex.
+var wellKnownFormats = map[string]gojsonschema.FormatChecker{
+	"aws-arn":  regexFormatChecker{re: regexp.MustCompile(`^arn:[^:]*:[^:]*:[^:]*:[^:]*:.+$`)},
+	"cidr":     cidrFormatChecker{},
+	"duration": durationFormatChecker{},
+	"semver":   regexFormatChecker{re: semverPattern},
+}
+
+// registerFormats registers each entry of a Lambda schema response's
+// "formats" map as a gojsonschema format checker. A value that matches a
+// well-known checker id is resolved to that checker; anything else is
+// compiled as a regular expression.
+func registerFormats(formats map[string]string) {
+	for name, spec := range formats {
+		if checker, ok := wellKnownFormats[spec]; ok {
+			log.Printf("[INFO] registering format %q using built-in checker %q", name, spec)
+			gojsonschema.FormatCheckers.Add(name, checker)
+			continue
+		}
+		re, err := regexp.Compile(spec)
+		if err != nil {
+			log.Printf("[WARN] format %q: %q is neither a known checker id nor a valid regex: %v", name, spec, err)
+			continue
+		}
+		log.Printf("[INFO] registering format %q using regex %q", name, spec)
+		gojsonschema.FormatCheckers.Add(name, regexFormatChecker{re: re})
+	}
+}
+
+// applyOperations walks doc alongside schemaNode's "properties" and runs any
+// "x-operations" declared on a property against that property's value. It
+// recurses into nested objects so operations can be declared at any depth.
+func applyOperations(schemaNode map[string]interface{}, doc map[string]interface{}) {
+	if schemaNode == nil || doc == nil {
+		return
+	}
+	props, _ := schemaNode["properties"].(map[string]interface{})
+	for key, rawPropSchema := range props {
+		propSchema, ok := rawPropSchema.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if childDoc, ok := doc[key].(map[string]interface{}); ok {
+			applyOperations(propSchema, childDoc)
+		}
+
+		ops := toStringSlice(propSchema["x-operations"])
+		if len(ops) == 0 {
+			continue
+		}
+
+		value, present := doc[key]
+		for _, op := range ops {
+			value, present = runOperation(op, propSchema, value, present)
+		}
+		if present {
+			doc[key] = value
+		}
+	}
+}
+
+func runOperation(op string, propSchema map[string]interface{}, value interface{}, present bool) (interface{}, bool) {
+	switch op {
+	case "trim":
+		if s, ok := value.(string); ok {
+			value = strings.TrimSpace(s)
+		}
+	case "lowercase":
+		if s, ok := value.(string); ok {
+			value = strings.ToLower(s)
+		}
+	case "default":
+		if !present || value == nil || value == "" {
+			if def, ok := propSchema["default"]; ok {
+				value, present = def, true
+			}
+		}
+	case "redact":
+		value, present = "***redacted***", true
+	default:
+		log.Printf("[WARN] unknown x-operations entry %q", op)
+	}
+	return value, present
+}
+
+// toStringSlice normalizes either a raw JSON-decoded []interface{} of strings
+// or an already-typed []string (as produced by mergeSchemaFragment when
+// composing schemas) into a plain []string.
+func toStringSlice(raw interface{}) []string {
+	switch arr := raw.(type) {
+	case []string:
+		return arr
+	case []interface{}:
+		out := make([]string, 0, len(arr))
+		for _, v := range arr {
+			if s, ok := v.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
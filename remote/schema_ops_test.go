@@ -0,0 +1,140 @@
+package remote
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestApplyOperations(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema map[string]interface{}
+		doc    map[string]interface{}
+		want   map[string]interface{}
+	}{
+		{
+			name: "trim and lowercase chained in order",
+			schema: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"name": map[string]interface{}{
+						"x-operations": []interface{}{"trim", "lowercase"},
+					},
+				},
+			},
+			doc:  map[string]interface{}{"name": "  Alice  "},
+			want: map[string]interface{}{"name": "alice"},
+		},
+		{
+			name: "default only applies when value absent or empty",
+			schema: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"region": map[string]interface{}{
+						"x-operations": []interface{}{"default"},
+						"default":      "us-east-1",
+					},
+				},
+			},
+			doc:  map[string]interface{}{"region": ""},
+			want: map[string]interface{}{"region": "us-east-1"},
+		},
+		{
+			name: "default left alone when value already present",
+			schema: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"region": map[string]interface{}{
+						"x-operations": []interface{}{"default"},
+						"default":      "us-east-1",
+					},
+				},
+			},
+			doc:  map[string]interface{}{"region": "eu-west-1"},
+			want: map[string]interface{}{"region": "eu-west-1"},
+		},
+		{
+			name: "redact overwrites regardless of prior value",
+			schema: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"password": map[string]interface{}{
+						"x-operations": []interface{}{"redact"},
+					},
+				},
+			},
+			doc:  map[string]interface{}{"password": "hunter2"},
+			want: map[string]interface{}{"password": "***redacted***"},
+		},
+		{
+			name: "recurses into nested objects",
+			schema: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"tags": map[string]interface{}{
+						"properties": map[string]interface{}{
+							"env": map[string]interface{}{
+								"x-operations": []interface{}{"lowercase"},
+							},
+						},
+					},
+				},
+			},
+			doc: map[string]interface{}{
+				"tags": map[string]interface{}{"env": "PROD"},
+			},
+			want: map[string]interface{}{
+				"tags": map[string]interface{}{"env": "prod"},
+			},
+		},
+		{
+			name: "no x-operations is a no-op",
+			schema: map[string]interface{}{
+				"properties": map[string]interface{}{
+					"name": map[string]interface{}{},
+				},
+			},
+			doc:  map[string]interface{}{"name": "Alice"},
+			want: map[string]interface{}{"name": "Alice"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			applyOperations(tt.schema, tt.doc)
+			if !reflect.DeepEqual(tt.doc, tt.want) {
+				t.Errorf("applyOperations() = %#v, want %#v", tt.doc, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyOperationsNilInputs(t *testing.T) {
+	// Neither a nil schema nor a nil doc should panic; both are no-ops.
+	applyOperations(nil, map[string]interface{}{"a": 1})
+	applyOperations(map[string]interface{}{"properties": map[string]interface{}{}}, nil)
+}
+
+func TestFormatCheckers(t *testing.T) {
+	tests := []struct {
+		name    string
+		checker interface{ IsFormat(interface{}) bool }
+		valid   string
+		invalid string
+	}{
+		{"aws-arn", wellKnownFormats["aws-arn"], "arn:aws:lambda:us-east-1:123456789012:function:my-fn", "not-an-arn"},
+		{"cidr", wellKnownFormats["cidr"], "10.0.0.0/24", "10.0.0.0"},
+		{"duration", wellKnownFormats["duration"], "15s", "fifteen seconds"},
+		{"semver", wellKnownFormats["semver"], "v1.2.3", "1.2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.checker.IsFormat(tt.valid) {
+				t.Errorf("%q: expected %q to be valid", tt.name, tt.valid)
+			}
+			if tt.checker.IsFormat(tt.invalid) {
+				t.Errorf("%q: expected %q to be invalid", tt.name, tt.invalid)
+			}
+		})
+	}
+}
+
+func TestRegisterFormatsSkipsInvalidRegex(t *testing.T) {
+	// An invalid regex spec must be skipped, not panic the provider.
+	registerFormats(map[string]string{"broken": `(unterminated`})
+}